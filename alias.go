@@ -0,0 +1,84 @@
+package rand
+
+import "fmt"
+
+// AliasTable implements Vose's alias method for weighted discrete sampling:
+// an O(N) preprocessing step (NewAliasTable) enables O(1) draws (Pick)
+// thereafter. This is much faster than repeated IntN plus a linear scan when
+// picking e.g. a light source or a BRDF lobe with fixed, reused weights.
+type AliasTable struct {
+	prob  []float64
+	alias []int
+}
+
+// NewAliasTable builds an AliasTable from the given (unnormalized,
+// non-negative) weights. It returns an error if weights is empty, contains a
+// negative value, or sums to zero.
+func NewAliasTable(weights []float64) (*AliasTable, error) {
+	n := len(weights)
+	if n == 0 {
+		return nil, fmt.Errorf("rand: NewAliasTable needs at least one weight")
+	}
+	var sum float64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, fmt.Errorf("rand: NewAliasTable weights must be non-negative, got %v", w)
+		}
+		sum += w
+	}
+	if sum <= 0 {
+		return nil, fmt.Errorf("rand: NewAliasTable weights must sum to a positive value")
+	}
+	prob := make([]float64, n)
+	alias := make([]int, n)
+	scaled := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / sum
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+		prob[s] = scaled[s]
+		alias[s] = l
+		scaled[l] += scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	for _, l := range large {
+		prob[l] = 1
+	}
+	for _, s := range small {
+		prob[s] = 1
+	}
+	return &AliasTable{prob: prob, alias: alias}, nil
+}
+
+// Pick draws an index i with probability weights[i]/sum in O(1), using r as
+// the source of randomness.
+func (a *AliasTable) Pick(r Rand) int {
+	i := r.IntN(len(a.prob))
+	if r.Float64() < a.prob[i] {
+		return i
+	}
+	return a.alias[i]
+}
+
+// PickN fills dst with len(dst) independent draws from Pick and returns dst.
+func (a *AliasTable) PickN(r Rand, dst []int) []int {
+	for i := range dst {
+		dst[i] = a.Pick(r)
+	}
+	return dst
+}
@@ -0,0 +1,64 @@
+package rand_test
+
+import (
+	"math"
+	"testing"
+
+	"fortio.org/rand"
+)
+
+func TestAliasTablePick(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping distribution test in short mode")
+	}
+	weights := []float64{1.0, 2.0, 3.0, 4.0}
+	table, err := rand.NewAliasTable(weights)
+	if err != nil {
+		t.Fatalf("NewAliasTable() error = %v", err)
+	}
+	r := rand.NewRand(55)
+	const samples = 1000000
+	counts := make([]int, len(weights))
+	for range samples {
+		counts[table.Pick(r)]++
+	}
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	const tolerance = 0.01 // 1% of total probability mass
+	for i, w := range weights {
+		expected := w / sum
+		got := float64(counts[i]) / samples
+		if math.Abs(got-expected) > tolerance {
+			t.Errorf("frequency[%d] = %.4f, want ≈%.4f (within %.4f)", i, got, expected, tolerance)
+		}
+	}
+}
+
+func TestAliasTablePickN(t *testing.T) {
+	table, err := rand.NewAliasTable([]float64{1, 1, 1})
+	if err != nil {
+		t.Fatalf("NewAliasTable() error = %v", err)
+	}
+	r := rand.NewRand(7)
+	dst := make([]int, 100)
+	table.PickN(r, dst)
+	for i, v := range dst {
+		if v < 0 || v >= 3 {
+			t.Errorf("PickN()[%d] = %d, want in [0,3)", i, v)
+		}
+	}
+}
+
+func TestNewAliasTableErrors(t *testing.T) {
+	if _, err := rand.NewAliasTable(nil); err == nil {
+		t.Error("NewAliasTable(nil), want error")
+	}
+	if _, err := rand.NewAliasTable([]float64{1, -1}); err == nil {
+		t.Error("NewAliasTable with negative weight, want error")
+	}
+	if _, err := rand.NewAliasTable([]float64{0, 0}); err == nil {
+		t.Error("NewAliasTable with all-zero weights, want error")
+	}
+}
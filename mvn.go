@@ -0,0 +1,132 @@
+package rand
+
+import (
+	"fmt"
+	"math"
+)
+
+// MVNSampler draws correlated samples from a multivariate normal distribution
+// N(μ, Σ). The Cholesky factorization of Σ is computed once at construction
+// time and cached, so each Sample call only costs a matrix-vector product.
+type MVNSampler struct {
+	mu    []float64
+	sigma [][]float64 // kept around so Marginal() can extract submatrices
+	l     [][]float64 // lower-triangular Cholesky factor, L, such that Σ = L·Lᵀ
+	r     Rand
+}
+
+// NewMVNSampler creates a MVNSampler for N(mu, sigma), where sigma is the NxN
+// symmetric positive-definite covariance matrix. It returns an error if the
+// dimensions don't match or sigma isn't positive-definite.
+func NewMVNSampler(r Rand, mu []float64, sigma [][]float64) (*MVNSampler, error) {
+	n := len(mu)
+	if len(sigma) != n {
+		return nil, fmt.Errorf("rand: mu has %d elements, sigma has %d rows", n, len(sigma))
+	}
+	for i, row := range sigma {
+		if len(row) != n {
+			return nil, fmt.Errorf("rand: sigma row %d has %d elements, want %d", i, len(row), n)
+		}
+	}
+	l, err := cholesky(sigma)
+	if err != nil {
+		return nil, err
+	}
+	sigmaCopy := make([][]float64, n)
+	for i, row := range sigma {
+		sigmaCopy[i] = append([]float64(nil), row...)
+	}
+	return &MVNSampler{mu: append([]float64(nil), mu...), sigma: sigmaCopy, l: l, r: r}, nil
+}
+
+// NewMVNSamplerFlat is like NewMVNSampler but takes sigma as a flat, row-major
+// N*N slice instead of [][]float64.
+func NewMVNSamplerFlat(r Rand, mu []float64, sigma []float64) (*MVNSampler, error) {
+	n := len(mu)
+	if len(sigma) != n*n {
+		return nil, fmt.Errorf("rand: flat sigma has %d elements, want %d for a %d-dim mu", len(sigma), n*n, n)
+	}
+	rows := make([][]float64, n)
+	for i := range n {
+		rows[i] = sigma[i*n : (i+1)*n]
+	}
+	return NewMVNSampler(r, mu, rows)
+}
+
+// cholesky computes the lower-triangular Cholesky factor L of the symmetric
+// positive-definite matrix sigma, such that sigma = L·Lᵀ. L is stored as a
+// ragged slice, row i holding only its i+1 non-zero entries.
+func cholesky(sigma [][]float64) ([][]float64, error) {
+	n := len(sigma)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, i+1)
+	}
+	for i := range n {
+		for j := 0; j <= i; j++ {
+			sum := sigma[i][j]
+			for k := range j {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, fmt.Errorf("rand: covariance matrix is not positive-definite")
+				}
+				l[i][j] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// Sample draws one length-N vector from N(μ, Σ), writing it into dst[:N] and
+// returning that slice. If dst doesn't have enough capacity, a new slice is
+// allocated instead; pass nil to always allocate. Any existing contents of
+// dst[:N] are overwritten, not preserved.
+func (m *MVNSampler) Sample(dst []float64) []float64 {
+	n := len(m.mu)
+	if cap(dst) < n {
+		dst = make([]float64, n)
+	} else {
+		dst = dst[:n]
+	}
+	z := make([]float64, n)
+	for i := range z {
+		z[i] = m.r.NormFloat64()
+	}
+	for i := range n {
+		sum := m.mu[i]
+		for j := 0; j <= i; j++ {
+			sum += m.l[i][j] * z[j]
+		}
+		dst[i] = sum
+	}
+	return dst
+}
+
+// Marginal returns a new MVNSampler for the marginal distribution of the
+// requested component indices, extracting the corresponding subvector of μ and
+// submatrix of Σ. It panics if an index is out of range, same as a bad slice
+// index would.
+func (m *MVNSampler) Marginal(indices []int) *MVNSampler {
+	n := len(indices)
+	mu := make([]float64, n)
+	sigma := make([][]float64, n)
+	for i, idx := range indices {
+		mu[i] = m.mu[idx]
+		row := make([]float64, n)
+		for j, jdx := range indices {
+			row[j] = m.sigma[idx][jdx]
+		}
+		sigma[i] = row
+	}
+	sub, err := NewMVNSampler(m.r, mu, sigma)
+	if err != nil {
+		// A principal submatrix of a positive-definite matrix is always
+		// positive-definite, so this only happens for malformed indices.
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,112 @@
+package rand_test
+
+import (
+	"math"
+	"testing"
+
+	"fortio.org/rand"
+)
+
+func TestMVNSamplerMeanAndCovariance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping distribution test in short mode")
+	}
+	mu := []float64{1.0, -2.0, 0.5}
+	sigma := [][]float64{
+		{2.0, 0.6, 0.3},
+		{0.6, 1.0, -0.4},
+		{0.3, -0.4, 1.5},
+	}
+	r := rand.NewRand(123)
+	sampler, err := rand.NewMVNSampler(r, mu, sigma)
+	if err != nil {
+		t.Fatalf("NewMVNSampler() error = %v", err)
+	}
+
+	const n = 200000
+	const dims = 3
+	var sum [dims]float64
+	var sumProd [dims][dims]float64
+	var dst []float64
+	for range n {
+		dst = sampler.Sample(dst)
+		for i := range dims {
+			sum[i] += dst[i]
+		}
+		for i := range dims {
+			for j := range dims {
+				sumProd[i][j] += dst[i] * dst[j]
+			}
+		}
+	}
+
+	const meanTolerance = 0.02
+	for i := range dims {
+		mean := sum[i] / n
+		if math.Abs(mean-mu[i]) > meanTolerance {
+			t.Errorf("mean[%d] = %.4f, want ≈%.4f (within %.4f)", i, mean, mu[i], meanTolerance)
+		}
+	}
+
+	const covTolerance = 0.05
+	for i := range dims {
+		for j := range dims {
+			mean := sumProd[i][j] / n
+			cov := mean - (sum[i]/n)*(sum[j]/n)
+			if math.Abs(cov-sigma[i][j]) > covTolerance {
+				t.Errorf("cov[%d][%d] = %.4f, want ≈%.4f (within %.4f)", i, j, cov, sigma[i][j], covTolerance)
+			}
+		}
+	}
+}
+
+func TestMVNSamplerMarginal(t *testing.T) {
+	mu := []float64{1.0, -2.0, 0.5}
+	sigma := [][]float64{
+		{2.0, 0.6, 0.3},
+		{0.6, 1.0, -0.4},
+		{0.3, -0.4, 1.5},
+	}
+	r := rand.NewRand(42)
+	sampler, err := rand.NewMVNSampler(r, mu, sigma)
+	if err != nil {
+		t.Fatalf("NewMVNSampler() error = %v", err)
+	}
+	marginal := sampler.Marginal([]int{2, 0})
+	dst := marginal.Sample(nil)
+	if len(dst) != 2 {
+		t.Fatalf("Marginal Sample() len = %d, want 2", len(dst))
+	}
+}
+
+func TestNewMVNSamplerDimensionMismatch(t *testing.T) {
+	r := rand.NewRand(1)
+	_, err := rand.NewMVNSampler(r, []float64{0, 0}, [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}})
+	if err == nil {
+		t.Error("NewMVNSampler() with mismatched dimensions, want error")
+	}
+}
+
+func TestNewMVNSamplerNotPositiveDefinite(t *testing.T) {
+	r := rand.NewRand(1)
+	mu := []float64{0, 0}
+	sigma := [][]float64{{1, 2}, {2, 1}} // not positive-definite
+	_, err := rand.NewMVNSampler(r, mu, sigma)
+	if err == nil {
+		t.Error("NewMVNSampler() with non positive-definite sigma, want error")
+	}
+}
+
+func TestNewMVNSamplerFlat(t *testing.T) {
+	r := rand.NewRand(7)
+	mu := []float64{0, 0}
+	flat := []float64{1, 0, 0, 1}
+	sampler, err := rand.NewMVNSamplerFlat(r, mu, flat)
+	if err != nil {
+		t.Fatalf("NewMVNSamplerFlat() error = %v", err)
+	}
+	dst := sampler.Sample(nil)
+	if len(dst) != 2 {
+		t.Fatalf("Sample() len = %d, want 2", len(dst))
+	}
+}
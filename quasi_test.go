@@ -0,0 +1,87 @@
+package rand_test
+
+import (
+	"math"
+	"testing"
+
+	"fortio.org/rand"
+)
+
+func TestStratifiedGrid2D(t *testing.T) {
+	r := rand.NewRand(1)
+	nx, ny := 8, 5
+	pts := r.StratifiedGrid2D(nx, ny)
+	if len(pts) != nx*ny {
+		t.Fatalf("StratifiedGrid2D(%d,%d) returned %d points, want %d", nx, ny, len(pts), nx*ny)
+	}
+	for i, p := range pts {
+		if p[0] < 0 || p[0] >= 1 || p[1] < 0 || p[1] >= 1 {
+			t.Errorf("point %d = %v, want in [0,1)^2", i, p)
+		}
+	}
+}
+
+func TestLowDiscrepancyNext2D(t *testing.T) {
+	r := rand.NewRand(2)
+	ld := rand.NewLowDiscrepancy(r)
+	const samples = 1000
+	seen := map[[2]float64]struct{}{}
+	for range samples {
+		u, v := ld.Next2D()
+		if u < 0 || u >= 1 || v < 0 || v >= 1 {
+			t.Fatalf("Next2D() = (%v,%v), want in [0,1)^2", u, v)
+		}
+		seen[[2]float64{u, v}] = struct{}{}
+	}
+	if len(seen) != samples {
+		t.Errorf("Next2D() produced %d unique points, want %d", len(seen), samples)
+	}
+}
+
+func TestLowDiscrepancyNext3D(t *testing.T) {
+	r := rand.NewRand(3)
+	ld := rand.NewLowDiscrepancy(r)
+	for range 1000 {
+		u, v, w := ld.Next3D()
+		for _, c := range []float64{u, v, w} {
+			if c < 0 || c >= 1 {
+				t.Fatalf("Next3D() component %v, want in [0,1)", c)
+			}
+		}
+	}
+}
+
+func TestInDiscStratified(t *testing.T) {
+	const radius = 2.0
+	for _, uv := range [][2]float64{{0, 0}, {0.25, 0.75}, {0.5, 0.5}, {0.99, 0.01}} {
+		x, y := rand.InDiscStratified(uv[0], uv[1], radius)
+		if dist := math.Sqrt(x*x + y*y); dist > radius+1e-9 {
+			t.Errorf("InDiscStratified(%v,%v,%v) = (%v,%v), distance %v exceeds radius", uv[0], uv[1], radius, x, y, dist)
+		}
+	}
+}
+
+func TestUnitVectorStratified(t *testing.T) {
+	for _, uv := range [][2]float64{{0, 0}, {0.25, 0.75}, {0.5, 0.5}, {0.99, 0.01}} {
+		x, y, z := rand.UnitVectorStratified(uv[0], uv[1])
+		length := math.Sqrt(x*x + y*y + z*z)
+		if math.Abs(length-1.0) > 1e-9 {
+			t.Errorf("UnitVectorStratified(%v,%v) length = %v, want 1.0", uv[0], uv[1], length)
+		}
+	}
+}
+
+func TestHemisphereCosine(t *testing.T) {
+	normal := [3]float64{0, 0, 1}
+	for _, uv := range [][2]float64{{0, 0}, {0.25, 0.75}, {0.5, 0.5}, {0.99, 0.01}} {
+		x, y, z := rand.HemisphereCosine(normal, uv[0], uv[1])
+		length := math.Sqrt(x*x + y*y + z*z)
+		if math.Abs(length-1.0) > 1e-9 {
+			t.Errorf("HemisphereCosine(%v,%v) length = %v, want 1.0", uv[0], uv[1], length)
+		}
+		dot := x*normal[0] + y*normal[1] + z*normal[2]
+		if dot < -1e-9 {
+			t.Errorf("HemisphereCosine(%v,%v) = (%v,%v,%v), dot with normal = %v, want >= 0", uv[0], uv[1], x, y, z, dot)
+		}
+	}
+}
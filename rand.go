@@ -17,6 +17,7 @@ import (
 // rng state is a pointer.
 type Rand struct {
 	rng *rand.Rand
+	src *rand.PCG // same generator as rng, kept to support (Un)MarshalBinary/Text
 }
 
 // NewRand generates a new Rand with the given seed. If seed is 0, a random seed is used.
@@ -42,7 +43,8 @@ func NewRandIdx(idx int, seed uint64) Rand {
 
 //nolint:gosec // not crypto use.
 func newRandSeeds(seed1, seed2 uint64) Rand {
-	return Rand{rng: rand.New(rand.NewPCG(seed1, seed2))}
+	src := rand.NewPCG(seed1, seed2)
+	return Rand{rng: rand.New(src), src: src}
 }
 
 // Forward methods to underlying rng
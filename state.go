@@ -0,0 +1,53 @@
+package rand
+
+import "encoding/base64"
+
+// MarshalBinary implements encoding.BinaryMarshaler, capturing the underlying
+// PCG state. The result can be stored and later passed to NewRandFromState (or
+// UnmarshalBinary) to resume the exact same pseudo-random sequence, enabling
+// checkpointing of long-running ray-trace/simulation jobs and deterministic
+// sharding across worker restarts.
+func (r Rand) MarshalBinary() ([]byte, error) {
+	return r.src.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, restoring a PCG state
+// previously captured by MarshalBinary into r, which must already have been
+// created by NewRand/NewRandIdx/NewRandFromState.
+func (r Rand) UnmarshalBinary(data []byte) error {
+	return r.src.UnmarshalBinary(data)
+}
+
+// MarshalText implements encoding.TextMarshaler, base64-encoding the same
+// state as MarshalBinary for use in text-based formats (JSON, YAML, env vars).
+func (r Rand) MarshalText() ([]byte, error) {
+	data, err := r.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(buf, data)
+	return buf, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the base64-decoding
+// counterpart of MarshalText.
+func (r Rand) UnmarshalText(text []byte) error {
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(data, text)
+	if err != nil {
+		return err
+	}
+	return r.UnmarshalBinary(data[:n])
+}
+
+// NewRandFromState reconstructs a Rand from a state previously captured with
+// MarshalBinary, continuing the exact same pseudo-random sequence from where
+// it left off.
+func NewRandFromState(state []byte) (Rand, error) {
+	r := newRandSeeds(0, 0) // placeholder, overwritten by UnmarshalBinary below.
+	if err := r.UnmarshalBinary(state); err != nil {
+		return Rand{}, err
+	}
+	return r, nil
+}
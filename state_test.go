@@ -0,0 +1,56 @@
+package rand_test
+
+import (
+	"testing"
+
+	"fortio.org/rand"
+)
+
+func TestRandMarshalUnmarshalBinary(t *testing.T) {
+	r := rand.NewRand(99)
+	// Advance the sequence a bit before checkpointing.
+	for range 10 {
+		r.Float64()
+	}
+	state, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	restored, err := rand.NewRandFromState(state)
+	if err != nil {
+		t.Fatalf("NewRandFromState() error = %v", err)
+	}
+
+	for i := range 100 {
+		want := r.Float64()
+		got := restored.Float64()
+		if got != want {
+			t.Errorf("sample %d: restored Float64() = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestRandMarshalUnmarshalText(t *testing.T) {
+	r := rand.NewRand(7)
+	for range 5 {
+		r.Uint64()
+	}
+	text, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+
+	restored := rand.NewRand(1) // arbitrary seed, overwritten by UnmarshalText
+	if err := restored.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+
+	for i := range 50 {
+		want := r.Uint64()
+		got := restored.Uint64()
+		if got != want {
+			t.Errorf("sample %d: restored Uint64() = %v, want %v", i, got, want)
+		}
+	}
+}